@@ -24,8 +24,8 @@ type MetricsServer struct {
 type GitRequestType string
 
 const (
-	GitRequestTypeLsRemote = "ls-remote"
-	GitRequestTypeFetch    = "fetch"
+	GitRequestTypeLsRemote GitRequestType = "ls-remote"
+	GitRequestTypeFetch    GitRequestType = "fetch"
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics.